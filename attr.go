@@ -0,0 +1,119 @@
+package htm
+
+import "strconv"
+
+// boolAttr returns a new boolean attribute that renders using the HTML5
+// boolean shorthand (e.g. <input disabled>) rather than name="value".
+func boolAttr(name string) Element {
+	return build("__attr", false, false).withBoolAttr(name)
+}
+
+// Value returns a new value attribute.
+func Value(value string) Element {
+	return Attr("value", value)
+}
+
+// Placeholder returns a new placeholder attribute.
+func Placeholder(placeholder string) Element {
+	return Attr("placeholder", placeholder)
+}
+
+// Target returns a new target attribute.
+func Target(target string) Element {
+	return Attr("target", target)
+}
+
+// TabIndex returns a new tabindex attribute.
+func TabIndex(index int) Element {
+	return Attr("tabindex", strconv.Itoa(index))
+}
+
+// AriaLabel returns a new aria-label attribute.
+func AriaLabel(label string) Element {
+	return Attr("aria-label", label)
+}
+
+// Data returns a new data-* attribute.
+func Data(key, value string) Element {
+	return Attr("data-"+key, value)
+}
+
+// Disabled returns a new disabled attribute.
+func Disabled() Element {
+	return boolAttr("disabled")
+}
+
+// Checked returns a new checked attribute.
+func Checked() Element {
+	return boolAttr("checked")
+}
+
+// Selected returns a new selected attribute.
+func Selected() Element {
+	return boolAttr("selected")
+}
+
+// ReadOnly returns a new readonly attribute.
+func ReadOnly() Element {
+	return boolAttr("readonly")
+}
+
+// Required returns a new required attribute.
+func Required() Element {
+	return boolAttr("required")
+}
+
+// Multiple returns a new multiple attribute.
+func Multiple() Element {
+	return boolAttr("multiple")
+}
+
+// Autofocus returns a new autofocus attribute.
+func Autofocus() Element {
+	return boolAttr("autofocus")
+}
+
+// Hidden returns a new hidden attribute.
+func Hidden() Element {
+	return boolAttr("hidden")
+}
+
+// OnClick returns a new onclick event handler attribute.
+func OnClick(js string) Element {
+	return Attr("onclick", js)
+}
+
+// OnChange returns a new onchange event handler attribute.
+func OnChange(js string) Element {
+	return Attr("onchange", js)
+}
+
+// OnInput returns a new oninput event handler attribute.
+func OnInput(js string) Element {
+	return Attr("oninput", js)
+}
+
+// OnSubmit returns a new onsubmit event handler attribute.
+func OnSubmit(js string) Element {
+	return Attr("onsubmit", js)
+}
+
+// OnFocus returns a new onfocus event handler attribute.
+func OnFocus(js string) Element {
+	return Attr("onfocus", js)
+}
+
+// OnBlur returns a new onblur event handler attribute.
+func OnBlur(js string) Element {
+	return Attr("onblur", js)
+}
+
+// OnKeyDown returns a new onkeydown event handler attribute.
+func OnKeyDown(js string) Element {
+	return Attr("onkeydown", js)
+}
+
+// OnLoad returns a new onload event handler attribute.
+func OnLoad(js string) Element {
+	return Attr("onload", js)
+}
@@ -0,0 +1,76 @@
+package htm
+
+import (
+	"context"
+	"io"
+)
+
+// Component is an element whose construction depends on a context, e.g. a
+// request-scoped value such as the current user or locale.
+type Component func(ctx context.Context) Element
+
+// Render evaluates c with ctx and writes the resulting element to w.
+func (c Component) Render(ctx context.Context, w io.Writer) (int64, error) {
+	return c(ctx).RenderTo(w)
+}
+
+// switchCase is a single branch of a Switch, matched by value equality.
+type switchCase[T comparable] struct {
+	match T
+	body  Element
+}
+
+// Case returns a new Switch branch that renders body when its Switch's
+// value equals match.
+func Case[T comparable](match T, body Element) switchCase[T] {
+	return switchCase[T]{match: match, body: body}
+}
+
+// Switch returns the body of the first case whose match equals value, or
+// Empty if none match.
+func Switch[T comparable](value T, cases ...switchCase[T]) Element {
+	for _, c := range cases {
+		if c.match == value {
+			return c.body
+		}
+	}
+
+	return Empty()
+}
+
+// Unless returns body only if cond is false.
+// Equivalent to: If(!cond, body, Empty())
+func Unless(cond bool, body Element) Element {
+	return If(!cond, body, Empty())
+}
+
+// deferred is an Element that evaluates its body lazily, only when
+// rendered.
+type deferred struct {
+	fn func() Element
+}
+
+// Defer returns an element that evaluates fn only when rendered, so that
+// expensive branches (e.g. the losing side of If) aren't constructed
+// unless they're actually used.
+func Defer(fn func() Element) Element {
+	return deferred{fn: fn}
+}
+
+func (d deferred) Render() string {
+	return d.fn().Render()
+}
+
+func (d deferred) RenderTo(w io.Writer) (int64, error) {
+	return d.fn().RenderTo(w)
+}
+
+// KeyedMap behaves like Map, but records a stable key on each rendered
+// element (as a data-key attribute) so downstream diffing tools can
+// identify list items across renders. The key is only recorded on
+// elements built with Make; Text, Raw and Fragment results carry no key.
+func KeyedMap[T any](values []T, keyFn func(T) string, iter func(T) Element) Element {
+	return MapIdx(values, func(v T, _ int) Element {
+		return Join(iter(v), Data("key", keyFn(v)))
+	})
+}
@@ -0,0 +1,52 @@
+package htm
+
+import (
+	"io"
+	"regexp"
+	"strings"
+)
+
+// nameRe matches a valid html tag or attribute name: a letter followed by
+// any number of letters, digits, hyphens, underscores or colons. Anything
+// that doesn't match is rejected rather than rendered, since it could
+// otherwise be used to break out of a tag or attribute.
+var nameRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9:_-]*$`)
+
+// validName reports whether s is a valid html tag or attribute name.
+func validName(s string) bool {
+	return nameRe.MatchString(s)
+}
+
+// escaper replaces the characters that are unsafe to emit verbatim in html
+// text content or attribute values.
+var escaper = strings.NewReplacer(
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+	`"`, "&#34;",
+	`'`, "&#39;",
+)
+
+// escape returns s with &, <, >, " and ' replaced by their html entities.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// raw is an Element that renders its contents verbatim, bypassing escaping.
+type raw string
+
+// Raw returns an element that renders s as-is, without escaping. Only use
+// this with trusted content; anything derived from user input should go
+// through Text or Attr instead.
+func Raw(s string) Element {
+	return raw(s)
+}
+
+func (r raw) Render() string {
+	return renderString(r)
+}
+
+func (r raw) RenderTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, string(r))
+	return int64(n), err
+}
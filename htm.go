@@ -2,21 +2,34 @@ package htm
 
 import (
 	"fmt"
-	"strings"
+	"io"
 )
 
 // Element represents a piece of html code.
 type Element interface {
+	// Render returns the element as a string.
 	Render() string
+
+	// RenderTo writes the element directly to w, avoiding the intermediate
+	// allocation Render incurs. It returns the number of bytes written.
+	RenderTo(w io.Writer) (int64, error)
 }
 
 // Make returns a new html element. Useful for creating new/non-standard elements.
 func Make(tag string, body ...Element) Element {
+	if !validName(tag) {
+		return Empty()
+	}
+
 	return build(tag, false, false).withBody(body)
 }
 
 // MakeSelfClosing returns a new self closing html element.
 func MakeSelfClosing(tag string, body ...Element) Element {
+	if !validName(tag) {
+		return Empty()
+	}
+
 	return build(tag, true, false).withBody(body)
 }
 
@@ -32,6 +45,10 @@ func Fragment(body ...Element) Element {
 
 // Attr returns a new attribute. Useful for creating new/non-standard attributes.
 func Attr(key, value string) Element {
+	if !validName(key) {
+		return Empty()
+	}
+
 	return build("__attr", false, false).withAttr(key, value)
 }
 
@@ -282,20 +299,36 @@ func Label(forName string, body ...Element) Element {
 type text string
 
 func (t text) Render() string {
-	return string(t)
+	return renderString(t)
+}
+
+func (t text) RenderTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, escape(string(t)))
+	return int64(n), err
 }
 
 type attribute struct {
-	name  string
-	value string
+	name    string
+	value   string
+	boolean bool
 }
 
 func (a *attribute) Render() string {
+	return renderString(a)
+}
+
+func (a *attribute) RenderTo(w io.Writer) (int64, error) {
 	if a == nil {
-		return ""
+		return 0, nil
+	}
+
+	if a.boolean {
+		n, err := io.WriteString(w, a.name)
+		return int64(n), err
 	}
 
-	return fmt.Sprintf("%s='%s'", a.name, a.value)
+	n, err := fmt.Fprintf(w, "%s=\"%s\"", a.name, escape(a.value))
+	return int64(n), err
 }
 
 type builder struct {
@@ -307,52 +340,87 @@ type builder struct {
 }
 
 func (b *builder) Render() string {
+	return renderString(b)
+}
+
+func (b *builder) RenderTo(w io.Writer) (int64, error) {
 	if b == nil || len(b.tag) == 0 {
-		return ""
+		return 0, nil
 	}
 
-	var sb strings.Builder
+	var total int64
 
 	if b.fragment {
 		for _, el := range b.body {
-			sb.WriteString(el.Render())
+			n, err := el.RenderTo(w)
+			total += n
+			if err != nil {
+				return total, err
+			}
 		}
 
-		return sb.String()
+		return total, nil
 	}
 
-	fmt.Fprintf(&sb, "<%s", b.tag)
+	n, err := fmt.Fprintf(w, "<%s", b.tag)
+	total += int64(n)
+	if err != nil {
+		return total, err
+	}
 
 	if len(b.attrs) > 0 {
-		sb.WriteByte(' ')
+		wn, err := io.WriteString(w, " ")
+		total += int64(wn)
+		if err != nil {
+			return total, err
+		}
+
 		for i, attr := range b.attrs {
 			a := attr.(*attribute)
-			sb.WriteString(a.Render())
+
+			an, err := a.RenderTo(w)
+			total += an
+			if err != nil {
+				return total, err
+			}
 
 			if i < len(b.attrs)-1 {
-				sb.WriteString(" ")
+				wn, err := io.WriteString(w, " ")
+				total += int64(wn)
+				if err != nil {
+					return total, err
+				}
 			}
 		}
 	}
 
 	if b.selfClosing {
-		sb.WriteString("/>")
-		return sb.String()
+		wn, err := io.WriteString(w, "/>")
+		total += int64(wn)
+		return total, err
 	}
 
-	sb.WriteString(">")
+	wn, err := io.WriteString(w, ">")
+	total += int64(wn)
+	if err != nil {
+		return total, err
+	}
 
 	for _, el := range b.body {
 		if el == nil {
 			continue
 		}
 
-		sb.WriteString(el.Render())
+		en, err := el.RenderTo(w)
+		total += en
+		if err != nil {
+			return total, err
+		}
 	}
 
-	fmt.Fprintf(&sb, "</%s>", b.tag)
-
-	return sb.String()
+	n, err = fmt.Fprintf(w, "</%s>", b.tag)
+	total += int64(n)
+	return total, err
 }
 
 func (b *builder) withBody(body []Element) Element {
@@ -375,6 +443,11 @@ func (b *builder) withAttr(name, value string) Element {
 	return b
 }
 
+func (b *builder) withBoolAttr(name string) Element {
+	b.attrs = append(b.attrs, &attribute{name: name, boolean: true})
+	return b
+}
+
 func build(t string, selfClosing, fragment bool) *builder {
 	return &builder{tag: t, selfClosing: selfClosing, fragment: fragment}
 }
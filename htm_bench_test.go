@@ -0,0 +1,46 @@
+package htm_test
+
+import (
+	"io"
+	"testing"
+
+	. "github.com/judah-caruso/htm"
+)
+
+// nestedDoc builds a representative deeply-nested document: a list of rows,
+// each with several nested elements and attributes.
+func nestedDoc() Element {
+	rows := make([]Element, 100)
+	for i := range rows {
+		rows[i] = ListItem(
+			Div(Class("row"), Id("row"),
+				Span(Text("col a")),
+				Span(Text("col b")),
+				A("#", Text("link")),
+			),
+		)
+	}
+
+	return Html(
+		Head(Title("bench")),
+		Body(Main(List(false, rows...))),
+	)
+}
+
+func BenchmarkRender(b *testing.B) {
+	doc := nestedDoc()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = doc.Render()
+	}
+}
+
+func BenchmarkRenderTo(b *testing.B) {
+	doc := nestedDoc()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		doc.RenderTo(io.Discard)
+	}
+}
@@ -1,6 +1,8 @@
 package htm_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	. "github.com/judah-caruso/htm"
@@ -18,6 +20,25 @@ func TestRendering(t *testing.T) {
 		{given: If(false, Text("true"), Text("false")), expected: "false"},
 		{given: If(true, Text("true"), Text("false")), expected: "true"},
 		{given: Link(".", "."), expected: `<link rel="." href="."/>`},
+		{given: Input(Disabled(), Value("x")), expected: `<input disabled value="x"></input>`},
+		{given: Input(Checked(), Selected(), ReadOnly(), Required(), Multiple(), Autofocus(), Hidden()), expected: `<input checked selected readonly required multiple autofocus hidden></input>`},
+		{given: Div(TabIndex(3), AriaLabel("close"), Data("id", "7"), Target("_blank"), Placeholder("name")), expected: `<div tabindex="3" aria-label="close" data-id="7" target="_blank" placeholder="name"></div>`},
+		{given: Button(OnClick("a()"), OnChange("b()"), OnInput("c()"), OnSubmit("d()"), OnFocus("e()"), OnBlur("f()"), OnKeyDown("g()"), OnLoad("h()")), expected: `<button onclick="a()" onchange="b()" oninput="c()" onsubmit="d()" onfocus="e()" onblur="f()" onkeydown="g()" onload="h()"></button>`},
+		{given: Text("<script>"), expected: "&lt;script&gt;"},
+		{given: Div(Attr("onclick", `'"`)), expected: `<div onclick="&#39;&#34;"></div>`},
+		{given: Raw("<b>raw</b>"), expected: "<b>raw</b>"},
+		{given: Make("div><script>"), expected: ""},
+		{given: Switch(2, Case(1, Text("one")), Case(2, Text("two"))), expected: "two"},
+		{given: Switch(9, Case(1, Text("one"))), expected: ""},
+		{given: Unless(false, Text("shown")), expected: "shown"},
+		{given: Unless(true, Text("hidden")), expected: ""},
+		{given: Defer(func() Element { return Text("lazy") }), expected: "lazy"},
+		{
+			given: KeyedMap([]string{"a", "b"}, func(s string) string { return s }, func(s string) Element {
+				return ListItem(Text(s))
+			}),
+			expected: `<li data-key="a">a</li><li data-key="b">b</li>`,
+		},
 	}
 
 	for _, c := range cases {
@@ -25,5 +46,33 @@ func TestRendering(t *testing.T) {
 		if c.expected != given {
 			t.Fatalf("expected %q, given %q", c.expected, given)
 		}
+
+		var sb strings.Builder
+		if _, err := c.given.RenderTo(&sb); err != nil {
+			t.Fatalf("RenderTo: %v", err)
+		}
+		if c.expected != sb.String() {
+			t.Fatalf("RenderTo: expected %q, given %q", c.expected, sb.String())
+		}
+	}
+}
+
+func TestComponent(t *testing.T) {
+	type userKey struct{}
+
+	greet := Component(func(ctx context.Context) Element {
+		name, _ := ctx.Value(userKey{}).(string)
+		return Text("hi %s", name)
+	})
+
+	ctx := context.WithValue(context.Background(), userKey{}, "ana")
+
+	var sb strings.Builder
+	if _, err := greet.Render(ctx, &sb); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if expected := "hi ana"; sb.String() != expected {
+		t.Fatalf("expected %q, given %q", expected, sb.String())
 	}
 }
@@ -0,0 +1,233 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/judah-caruso/htm"
+)
+
+// Cell dimensions used to lay the ascii grid out in SVG user units.
+const (
+	goatCellW = 9
+	goatCellH = 16
+)
+
+// Goat renders a GoAT-style ascii diagram (the contents of a ```goat
+// fenced block) as an inline SVG element. It tokenizes src into a
+// character grid, walks it recognizing line, corner and arrow glyphs, and
+// emits the corresponding SVG primitives. Any character that isn't part of
+// a recognized glyph is placed as a <text> element at its grid position.
+func Goat(src string) htm.Element {
+	grid := goatGrid(src)
+
+	width, height := 0, len(grid)
+	for _, row := range grid {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	seen := make([][]bool, height)
+	for y := range seen {
+		seen[y] = make([]bool, width)
+	}
+
+	var body []htm.Element
+	for y, row := range grid {
+		for x, ch := range row {
+			if seen[y][x] {
+				continue
+			}
+
+			switch ch {
+			case ' ', '\t':
+				// no glyph
+
+			case '-':
+				end := x
+				for end < len(row) && row[end] == '-' {
+					seen[y][end] = true
+					end++
+				}
+				body = append(body, goatLine(x, y, end-1, y))
+
+			case '|':
+				end := y
+				for end < height && goatAt(grid, end, x) == '|' {
+					seen[end][x] = true
+					end++
+				}
+				body = append(body, goatLine(x, y, x, end-1))
+
+			case '/', '\\':
+				seen[y][x] = true
+				body = append(body, goatDiagonal(x, y, ch))
+
+			case '+', '.', '\'':
+				seen[y][x] = true
+				body = append(body, goatJoint(grid, x, y, ch))
+
+			case '*':
+				seen[y][x] = true
+				body = append(body, goatDot(x, y))
+
+			case '>', '<', '^', 'v':
+				seen[y][x] = true
+				body = append(body, goatArrow(x, y, ch))
+
+			default:
+				seen[y][x] = true
+				body = append(body, goatText(x, y, ch))
+			}
+		}
+	}
+
+	return htm.Make("svg",
+		htm.Attr("xmlns", "http://www.w3.org/2000/svg"),
+		htm.Attr("viewBox", fmt.Sprintf("0 0 %d %d", width*goatCellW, height*goatCellH)),
+		htm.Fragment(body...),
+	)
+}
+
+func goatGrid(src string) [][]rune {
+	lines := strings.Split(strings.TrimRight(src, "\n"), "\n")
+
+	grid := make([][]rune, len(lines))
+	for i, line := range lines {
+		grid[i] = []rune(line)
+	}
+
+	return grid
+}
+
+func goatAt(grid [][]rune, y, x int) rune {
+	if y < 0 || y >= len(grid) || x < 0 || x >= len(grid[y]) {
+		return ' '
+	}
+
+	return grid[y][x]
+}
+
+func goatCenter(x, y int) (int, int) {
+	return x*goatCellW + goatCellW/2, y*goatCellH + goatCellH/2
+}
+
+func goatLine(x1, y1, x2, y2 int) htm.Element {
+	cx1, cy1 := goatCenter(x1, y1)
+	cx2, cy2 := goatCenter(x2, y2)
+
+	return htm.MakeSelfClosing("line",
+		htm.Attr("x1", fmt.Sprint(cx1)),
+		htm.Attr("y1", fmt.Sprint(cy1)),
+		htm.Attr("x2", fmt.Sprint(cx2)),
+		htm.Attr("y2", fmt.Sprint(cy2)),
+		htm.Attr("stroke", "currentColor"),
+	)
+}
+
+func goatDiagonal(x, y int, ch rune) htm.Element {
+	x1, y1 := x*goatCellW, y*goatCellH
+	x2, y2 := x1+goatCellW, y1+goatCellH
+	if ch == '/' {
+		x1, x2 = x2, x1
+	}
+
+	return htm.MakeSelfClosing("line",
+		htm.Attr("x1", fmt.Sprint(x1)),
+		htm.Attr("y1", fmt.Sprint(y1)),
+		htm.Attr("x2", fmt.Sprint(x2)),
+		htm.Attr("y2", fmt.Sprint(y2)),
+		htm.Attr("stroke", "currentColor"),
+	)
+}
+
+// goatJoint renders a '+', '.' or '\'' junction. '+' is a sharp corner,
+// drawn as a cross of straight segments. '.' and '\'' are rounded corners,
+// drawn as a quarter-circle curving down (for '.') or up (for '\'') into
+// the left neighbor when it is '-', and into the right neighbor otherwise.
+func goatJoint(grid [][]rune, x, y int, ch rune) htm.Element {
+	cx, cy := goatCenter(x, y)
+	hw, hh := goatCellW/2, goatCellH/2
+
+	if ch == '+' {
+		return htm.Fragment(
+			htm.MakeSelfClosing("line",
+				htm.Attr("x1", fmt.Sprint(cx-hw)),
+				htm.Attr("y1", fmt.Sprint(cy)),
+				htm.Attr("x2", fmt.Sprint(cx+hw)),
+				htm.Attr("y2", fmt.Sprint(cy)),
+				htm.Attr("stroke", "currentColor"),
+			),
+			htm.MakeSelfClosing("line",
+				htm.Attr("x1", fmt.Sprint(cx)),
+				htm.Attr("y1", fmt.Sprint(cy-hh)),
+				htm.Attr("x2", fmt.Sprint(cx)),
+				htm.Attr("y2", fmt.Sprint(cy+hh)),
+				htm.Attr("stroke", "currentColor"),
+			),
+		)
+	}
+
+	vy := hh
+	if ch == '\'' {
+		vy = -hh
+	}
+
+	hx := hw
+	if goatAt(grid, y, x-1) == '-' {
+		hx = -hw
+	}
+
+	return htm.MakeSelfClosing("path",
+		htm.Attr("d", fmt.Sprintf("M%d,%d Q%d,%d %d,%d", cx+hx, cy, cx, cy, cx, cy+vy)),
+		htm.Attr("fill", "none"),
+		htm.Attr("stroke", "currentColor"),
+	)
+}
+
+// goatDot renders a '*' marker as a small filled circle.
+func goatDot(x, y int) htm.Element {
+	cx, cy := goatCenter(x, y)
+
+	return htm.MakeSelfClosing("circle",
+		htm.Attr("cx", fmt.Sprint(cx)),
+		htm.Attr("cy", fmt.Sprint(cy)),
+		htm.Attr("r", fmt.Sprint(goatCellW/2)),
+		htm.Attr("fill", "currentColor"),
+	)
+}
+
+func goatArrow(x, y int, dir rune) htm.Element {
+	cx, cy := goatCenter(x, y)
+	hw, hh := goatCellW/2, goatCellH/2
+
+	var points string
+	switch dir {
+	case '>':
+		points = fmt.Sprintf("%d,%d %d,%d %d,%d", cx-hw, cy-hh, cx+hw, cy, cx-hw, cy+hh)
+	case '<':
+		points = fmt.Sprintf("%d,%d %d,%d %d,%d", cx+hw, cy-hh, cx-hw, cy, cx+hw, cy+hh)
+	case '^':
+		points = fmt.Sprintf("%d,%d %d,%d %d,%d", cx-hw, cy+hh, cx, cy-hh, cx+hw, cy+hh)
+	case 'v':
+		points = fmt.Sprintf("%d,%d %d,%d %d,%d", cx-hw, cy-hh, cx, cy+hh, cx+hw, cy-hh)
+	}
+
+	return htm.MakeSelfClosing("polygon",
+		htm.Attr("points", points),
+		htm.Attr("fill", "currentColor"),
+	)
+}
+
+func goatText(x, y int, ch rune) htm.Element {
+	cx, cy := goatCenter(x, y)
+
+	return htm.Make("text",
+		htm.Attr("x", fmt.Sprint(cx)),
+		htm.Attr("y", fmt.Sprint(cy)),
+		htm.Attr("text-anchor", "middle"),
+		htm.Attr("dominant-baseline", "middle"),
+		htm.Text("%s", string(ch)),
+	)
+}
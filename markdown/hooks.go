@@ -0,0 +1,114 @@
+// Package markdown converts markdown source into an htm.Element tree,
+// with a render-hook mechanism (modeled on Hugo's render hooks) that lets
+// callers override how individual node kinds are rendered.
+package markdown
+
+import (
+	"strconv"
+
+	"github.com/judah-caruso/htm"
+)
+
+// NodeType identifies a kind of markdown node a hook can render.
+type NodeType string
+
+const (
+	NodeCodeBlock NodeType = "codeblock"
+	NodeLink      NodeType = "link"
+	NodeImage     NodeType = "image"
+	NodeHeading   NodeType = "heading"
+)
+
+// CodeBlockContext is passed to codeblock hooks.
+type CodeBlockContext struct {
+	Language string
+	Attrs    map[string]string
+	Text     string
+	Ordinal  int
+}
+
+// LinkContext is passed to link hooks.
+type LinkContext struct {
+	Text string
+	Href string
+}
+
+// ImageContext is passed to image hooks.
+type ImageContext struct {
+	Alt string
+	Src string
+}
+
+// HeadingContext is passed to heading hooks.
+type HeadingContext struct {
+	Level int
+	Text  string
+}
+
+// Hook renders a single markdown node into an Element. The context passed
+// in is one of CodeBlockContext, LinkContext, ImageContext or
+// HeadingContext, depending on which key the hook was registered under.
+type Hook func(ctx any) htm.Element
+
+// Hooks is a render-hook registry keyed by node type. Code block hooks may
+// also be registered per-language via "codeblock:<lang>", which takes
+// priority over the plain "codeblock" hook when present.
+type Hooks struct {
+	hooks map[string]Hook
+}
+
+// NewHooks returns a registry seeded with the built-in default hooks: a
+// codeblock hook that emits escaped <pre><code>, a link hook that emits
+// <a>, an image hook that emits <img>, a heading hook that emits <h1>-<h6>,
+// and a "codeblock:goat" hook that renders GoAT ascii diagrams as SVG.
+func NewHooks() *Hooks {
+	h := &Hooks{hooks: map[string]Hook{}}
+	h.Register(string(NodeCodeBlock), defaultCodeBlockHook)
+	h.Register(string(NodeLink), defaultLinkHook)
+	h.Register(string(NodeImage), defaultImageHook)
+	h.Register(string(NodeHeading), defaultHeadingHook)
+	h.Register("codeblock:goat", goatHook)
+	return h
+}
+
+// Register sets the hook for the given key, overwriting any existing hook
+// registered under it.
+func (h *Hooks) Register(key string, hook Hook) {
+	h.hooks[key] = hook
+}
+
+func (h *Hooks) lookup(key string) (Hook, bool) {
+	hook, ok := h.hooks[key]
+	return hook, ok
+}
+
+func defaultCodeBlockHook(c any) htm.Element {
+	ctx := c.(CodeBlockContext)
+
+	class := "language-text"
+	if ctx.Language != "" {
+		class = "language-" + ctx.Language
+	}
+
+	return htm.Pre(htm.Code(htm.Class(class), htm.Text("%s", ctx.Text)))
+}
+
+func defaultLinkHook(c any) htm.Element {
+	ctx := c.(LinkContext)
+	return htm.A(ctx.Href, htm.Text("%s", ctx.Text))
+}
+
+func defaultImageHook(c any) htm.Element {
+	ctx := c.(ImageContext)
+	return htm.Img(ctx.Src, htm.Alt(ctx.Alt))
+}
+
+func defaultHeadingHook(c any) htm.Element {
+	ctx := c.(HeadingContext)
+	return htm.Make("h"+strconv.Itoa(ctx.Level), htm.Text("%s", ctx.Text))
+}
+
+func goatHook(c any) htm.Element {
+	ctx := c.(CodeBlockContext)
+	return Goat(ctx.Text)
+}
@@ -0,0 +1,171 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/judah-caruso/htm"
+)
+
+var headingRe = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+
+// inlineRe matches markdown links and images: [text](href) or ![alt](src).
+var inlineRe = regexp.MustCompile(`!?\[[^\]]*\]\([^)]*\)`)
+
+// infoAttrRe matches key="value" pairs in a fence info string's attribute
+// list, e.g. the caption="diagram" in `goat {caption="diagram"}`.
+var infoAttrRe = regexp.MustCompile(`([a-zA-Z_-]+)="([^"]*)"`)
+
+// parseInfoString splits a fenced code block's info string into its
+// language (the first token) and any attributes given after it, e.g.
+// `goat {caption="diagram"}` -> ("goat", {"caption": "diagram"}).
+func parseInfoString(info string) (string, map[string]string) {
+	attrs := map[string]string{}
+
+	fields := strings.Fields(info)
+	if len(fields) == 0 {
+		return "", attrs
+	}
+
+	lang := fields[0]
+	rest := strings.TrimSpace(info[len(lang):])
+	rest = strings.TrimPrefix(rest, "{")
+	rest = strings.TrimSuffix(rest, "}")
+
+	for _, m := range infoAttrRe.FindAllStringSubmatch(rest, -1) {
+		attrs[m[1]] = m[2]
+	}
+
+	return lang, attrs
+}
+
+// Convert parses markdown source into an Element tree. Code blocks,
+// headings, links and images are rendered through hooks, falling back to
+// NewHooks' defaults for anything hooks doesn't cover. Passing nil for
+// hooks uses the defaults for everything.
+func Convert(source string, hooks *Hooks) htm.Element {
+	if hooks == nil {
+		hooks = NewHooks()
+	}
+
+	lines := strings.Split(source, "\n")
+
+	var body []htm.Element
+	var ordinal int
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			continue
+
+		case strings.HasPrefix(strings.TrimLeft(line, " "), "```"):
+			info := strings.TrimSpace(strings.TrimPrefix(strings.TrimLeft(line, " "), "```"))
+			lang, attrs := parseInfoString(info)
+
+			var code []string
+			i++
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "```" {
+				code = append(code, lines[i])
+				i++
+			}
+
+			body = append(body, renderCodeBlock(hooks, CodeBlockContext{
+				Language: lang,
+				Attrs:    attrs,
+				Text:     strings.Join(code, "\n"),
+				Ordinal:  ordinal,
+			}))
+			ordinal++
+
+		case headingRe.MatchString(strings.TrimLeft(line, " ")):
+			m := headingRe.FindStringSubmatch(strings.TrimLeft(line, " "))
+			ctx := HeadingContext{Level: len(m[1]), Text: strings.TrimSpace(m[2])}
+
+			if hook, ok := hooks.lookup(string(NodeHeading)); ok {
+				body = append(body, hook(ctx))
+			} else {
+				body = append(body, defaultHeadingHook(ctx))
+			}
+
+		default:
+			var para []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+				para = append(para, lines[i])
+				i++
+			}
+			i--
+
+			body = append(body, htm.Make("p", parseInline(strings.Join(para, " "), hooks)...))
+		}
+	}
+
+	return htm.Fragment(body...)
+}
+
+func renderCodeBlock(hooks *Hooks, ctx CodeBlockContext) htm.Element {
+	if ctx.Language != "" {
+		if hook, ok := hooks.lookup("codeblock:" + ctx.Language); ok {
+			return hook(ctx)
+		}
+	}
+
+	if hook, ok := hooks.lookup(string(NodeCodeBlock)); ok {
+		return hook(ctx)
+	}
+
+	return defaultCodeBlockHook(ctx)
+}
+
+// parseInline splits s on markdown links/images, rendering the matched
+// spans through hooks and leaving everything else as plain Text.
+func parseInline(s string, hooks *Hooks) []htm.Element {
+	matches := inlineRe.FindAllStringIndex(s, -1)
+	if matches == nil {
+		return []htm.Element{htm.Text("%s", s)}
+	}
+
+	var out []htm.Element
+
+	last := 0
+	for _, m := range matches {
+		if m[0] > last {
+			out = append(out, htm.Text("%s", s[last:m[0]]))
+		}
+
+		out = append(out, renderInlineMatch(s[m[0]:m[1]], hooks))
+		last = m[1]
+	}
+
+	if last < len(s) {
+		out = append(out, htm.Text("%s", s[last:]))
+	}
+
+	return out
+}
+
+func renderInlineMatch(s string, hooks *Hooks) htm.Element {
+	isImage := strings.HasPrefix(s, "!")
+	if isImage {
+		s = s[1:]
+	}
+
+	closeBracket := strings.Index(s, "]")
+	text := s[1:closeBracket]
+	href := s[closeBracket+2 : len(s)-1]
+
+	if isImage {
+		ctx := ImageContext{Alt: text, Src: href}
+		if hook, ok := hooks.lookup(string(NodeImage)); ok {
+			return hook(ctx)
+		}
+		return defaultImageHook(ctx)
+	}
+
+	ctx := LinkContext{Text: text, Href: href}
+	if hook, ok := hooks.lookup(string(NodeLink)); ok {
+		return hook(ctx)
+	}
+	return defaultLinkHook(ctx)
+}
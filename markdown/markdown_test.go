@@ -0,0 +1,104 @@
+package markdown_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/judah-caruso/htm"
+	"github.com/judah-caruso/htm/markdown"
+)
+
+func TestConvert(t *testing.T) {
+	cases := []struct {
+		given    string
+		expected string
+	}{
+		{given: "# hello", expected: "<h1>hello</h1>"},
+		{given: "just text", expected: "<p>just text</p>"},
+		{given: "[go](https://go.dev)", expected: `<p><a href="https://go.dev">go</a></p>`},
+		{given: "```\nfoo\n```", expected: `<pre><code class="language-text">foo</code></pre>`},
+		{given: "```go\nfoo\n```", expected: `<pre><code class="language-go">foo</code></pre>`},
+		{given: "50% of the tests pass.", expected: "<p>50% of the tests pass.</p>"},
+		{given: "```\nfmt.Printf(\"%d items\\n\", n)\n```", expected: `<pre><code class="language-text">fmt.Printf(&#34;%d items\n&#34;, n)</code></pre>`},
+	}
+
+	for _, c := range cases {
+		given := markdown.Convert(c.given, nil).Render()
+		if c.expected != given {
+			t.Fatalf("expected %q, given %q", c.expected, given)
+		}
+	}
+}
+
+func TestGoat(t *testing.T) {
+	out := markdown.Goat("-->").Render()
+
+	if !strings.Contains(out, "<svg") {
+		t.Fatalf("expected an svg element, given %q", out)
+	}
+	if !strings.Contains(out, "<polygon") {
+		t.Fatalf("expected an arrowhead polygon, given %q", out)
+	}
+}
+
+func TestGoatJoints(t *testing.T) {
+	sharp := markdown.Goat("+--+").Render()
+	round := markdown.Goat(".--.").Render()
+
+	if sharp == round {
+		t.Fatalf("expected sharp ('+') and rounded ('.') corners to render differently")
+	}
+	if !strings.Contains(round, "<path") {
+		t.Fatalf("expected a rounded corner to render as a path, given %q", round)
+	}
+}
+
+func TestGoatDot(t *testing.T) {
+	out := markdown.Goat("*").Render()
+	if !strings.Contains(out, "<circle") {
+		t.Fatalf("expected '*' to render as a circle, given %q", out)
+	}
+}
+
+func TestFenceAttrs(t *testing.T) {
+	var gotLang string
+	var gotAttrs map[string]string
+
+	hooks := markdown.NewHooks()
+	hooks.Register(string(markdown.NodeCodeBlock), func(c any) Element {
+		ctx := c.(markdown.CodeBlockContext)
+		gotLang = ctx.Language
+		gotAttrs = ctx.Attrs
+		return Text("stub")
+	})
+
+	markdown.Convert("```go {caption=\"demo\"}\nfoo\n```", hooks).Render()
+
+	if gotLang != "go" {
+		t.Fatalf("expected language %q, given %q", "go", gotLang)
+	}
+	if gotAttrs["caption"] != "demo" {
+		t.Fatalf("expected caption attr %q, given %q", "demo", gotAttrs["caption"])
+	}
+}
+
+func TestGoatFenceWithAttrs(t *testing.T) {
+	out := markdown.Convert("```goat {caption=\"diagram\"}\n-->\n```", nil).Render()
+	if !strings.Contains(out, "<svg") {
+		t.Fatalf("expected the codeblock:goat hook to still match with attrs present, given %q", out)
+	}
+}
+
+func TestCustomHook(t *testing.T) {
+	hooks := markdown.NewHooks()
+	hooks.Register(string(markdown.NodeLink), func(c any) Element {
+		ctx := c.(markdown.LinkContext)
+		return A(ctx.Href, Class("external"), Text("%s", ctx.Text))
+	})
+
+	given := markdown.Convert("[go](https://go.dev)", hooks).Render()
+	expected := `<p><a class="external" href="https://go.dev">go</a></p>`
+	if given != expected {
+		t.Fatalf("expected %q, given %q", expected, given)
+	}
+}
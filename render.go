@@ -0,0 +1,29 @@
+package htm
+
+import (
+	"bytes"
+	"sync"
+)
+
+// builderPool holds reusable *bytes.Buffer values for Render, so that
+// rendering a document doesn't need to allocate a fresh buffer for every
+// Render call in a request-heavy server. bytes.Buffer.Reset keeps its
+// backing array (unlike strings.Builder.Reset, which discards it), so
+// buffers actually grow to a steady-state capacity instead of starting
+// from zero on every Get.
+var builderPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// renderString renders el into a pooled buffer and returns the result.
+// It's the shared implementation backing every Element's Render method.
+func renderString(el Element) string {
+	buf := builderPool.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	el.RenderTo(buf)
+	out := buf.String()
+
+	builderPool.Put(buf)
+	return out
+}